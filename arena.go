@@ -0,0 +1,310 @@
+package skiplist
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// nullOffset marks the end of a forward chain or, when held by the
+// head pointers, an empty list. Offset 0 is never assigned to a real
+// node since the arena's first byte is reserved for it.
+const nullOffset uint32 = 0
+
+// ErrArenaFull is returned by Set when there isn't enough room left in
+// the arena for the new node.
+var ErrArenaFull = errors.New("arena full")
+
+// MaxArenaLevel is the highest max level an ArenaSkipList supports: a
+// node's level is packed into a single header byte, so it must fit in
+// 0..255.
+const MaxArenaLevel = 256
+
+// ArenaSkipList is a byte-key skip list whose nodes, including their
+// forward-pointer arrays, are allocated from one pre-sized contiguous
+// byte slice instead of individual *Node allocations, with node
+// references stored as uint32 offsets into that slice. This mirrors
+// the inline skip lists RocksDB/Pebble/Badger use for memtables: a Set
+// only bumps an atomic-free offset and copies bytes in place, so
+// inserts create no per-node garbage and reads benefit from better
+// cache locality than chasing pointers.
+//
+// ArenaSkipList does not support Delete: memtables instead write a
+// tombstone value and rely on compaction, and the arena itself is
+// rotated out once it fills (see Full and ErrArenaFull).
+type ArenaSkipList struct {
+	mu sync.RWMutex
+
+	maxLevel int
+	level    int
+	p        float64
+	rnd      *rand.Rand
+
+	buf    []byte
+	offset uint32
+	full   bool
+
+	// head holds the head's forward pointers, one per level. It is not
+	// part of the arena: its size is fixed at maxLevel+1 uint32s and
+	// keeping it as an ordinary slice avoids reserving arena space for
+	// a node that never stores a key or value.
+	head []uint32
+}
+
+// NewArena creates an ArenaSkipList backed by a size-byte arena.
+// maxLevel is clamped to MaxArenaLevel, since a node's level is packed
+// into a single header byte.
+func NewArena(size, maxLevel int) *ArenaSkipList {
+	if maxLevel > MaxArenaLevel {
+		maxLevel = MaxArenaLevel
+	}
+
+	return &ArenaSkipList{
+		maxLevel: maxLevel,
+		p:        DefaultP,
+		rnd:      rand.New(rand.NewSource(time.Now().UnixNano())),
+		buf:      make([]byte, size),
+		offset:   1,
+		head:     make([]uint32, maxLevel+1),
+	}
+}
+
+// NewArenaDefault creates an ArenaSkipList backed by a size-byte arena,
+// using the default max level of 30.
+func NewArenaDefault(size int) *ArenaSkipList {
+	return NewArena(size, MaxLevel)
+}
+
+// Generates a level for a newly inserted node using the same geometric
+// distribution as SkipList.randomLevel.
+func (a *ArenaSkipList) randomLevel() int {
+	level := 0
+	for a.rnd.Float64() < a.p && level < a.maxLevel-1 {
+		level++
+	}
+	return level
+}
+
+// node layout, starting at its arena offset:
+//
+//	[0]             level, as a single byte (numForward = level+1)
+//	[1:]            numForward uint32 forward offsets
+//	[...:]          keyLen uint32, valLen uint32
+//	[...:]          key bytes, then value bytes
+
+func nodeSize(level, keyLen, valLen int) int {
+	return 1 + (level+1)*4 + 4 + 4 + keyLen + valLen
+}
+
+func (a *ArenaSkipList) nodeLevel(o uint32) int {
+	return int(a.buf[o])
+}
+
+func (a *ArenaSkipList) nodeForward(o uint32, i int) uint32 {
+	p := o + 1 + uint32(i)*4
+	return binary.LittleEndian.Uint32(a.buf[p : p+4])
+}
+
+func (a *ArenaSkipList) setNodeForward(o uint32, i int, v uint32) {
+	p := o + 1 + uint32(i)*4
+	binary.LittleEndian.PutUint32(a.buf[p:p+4], v)
+}
+
+func (a *ArenaSkipList) keyValOffsets(o uint32) (keyStart, keyLen, valStart, valLen uint32) {
+	numForward := uint32(a.buf[o]) + 1
+	metaStart := o + 1 + numForward*4
+	keyLen = binary.LittleEndian.Uint32(a.buf[metaStart : metaStart+4])
+	valLen = binary.LittleEndian.Uint32(a.buf[metaStart+4 : metaStart+8])
+	keyStart = metaStart + 8
+	valStart = keyStart + keyLen
+	return
+}
+
+func (a *ArenaSkipList) nodeKey(o uint32) []byte {
+	ks, kl, _, _ := a.keyValOffsets(o)
+	return a.buf[ks : ks+kl]
+}
+
+func (a *ArenaSkipList) nodeValue(o uint32) []byte {
+	_, _, vs, vl := a.keyValOffsets(o)
+	return a.buf[vs : vs+vl]
+}
+
+// forwardAt returns the forward pointer at level i from o, where o ==
+// nullOffset means "the head".
+func (a *ArenaSkipList) forwardAt(o uint32, i int) uint32 {
+	if o == nullOffset {
+		return a.head[i]
+	}
+	return a.nodeForward(o, i)
+}
+
+func (a *ArenaSkipList) setForwardAt(o uint32, i int, v uint32) {
+	if o == nullOffset {
+		a.head[i] = v
+	} else {
+		a.setNodeForward(o, i, v)
+	}
+}
+
+// alloc bumps the arena's free offset by n bytes and returns where the
+// allocation starts, or ErrArenaFull if it doesn't fit.
+func (a *ArenaSkipList) alloc(n int) (uint32, error) {
+	if int(a.offset)+n > len(a.buf) {
+		// Latch full: the arena may still have slack bytes (too few
+		// for this node but nonzero), and a future smaller insert
+		// could theoretically fit. We still want Full to report true
+		// once any insert has been rejected, since that's the signal
+		// a memtable rotates on.
+		a.full = true
+		return 0, ErrArenaFull
+	}
+
+	o := a.offset
+	a.offset += uint32(n)
+	return o, nil
+}
+
+// Set inserts key/value into the arena, or replaces the value if key
+// already exists. Replacing a key leaves its old bytes as unreclaimed
+// arena space; this is fine for the memtable use case the arena is
+// built for, since the whole arena is discarded once it fills and a
+// new one takes over. Set returns ErrArenaFull if there isn't enough
+// room left for the new node.
+func (a *ArenaSkipList) Set(key, value []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	update := make([]uint32, a.maxLevel+1)
+	curr := nullOffset
+
+	for i := a.level; i >= 0; i-- {
+		next := a.forwardAt(curr, i)
+		for next != nullOffset && bytes.Compare(a.nodeKey(next), key) == -1 {
+			curr = next
+			next = a.forwardAt(curr, i)
+		}
+		update[i] = curr
+	}
+
+	if existing := a.forwardAt(curr, 0); existing != nullOffset && bytes.Equal(a.nodeKey(existing), key) {
+		existingLevel := a.nodeLevel(existing)
+		for i := 0; i <= existingLevel; i++ {
+			a.setForwardAt(update[i], i, a.nodeForward(existing, i))
+		}
+		for a.level > 0 && a.head[a.level] == nullOffset {
+			a.level--
+		}
+	}
+
+	rLevel := a.randomLevel()
+	if rLevel > a.level {
+		for i := a.level + 1; i <= rLevel; i++ {
+			update[i] = nullOffset
+		}
+		a.level = rLevel
+	}
+
+	o, err := a.alloc(nodeSize(rLevel, len(key), len(value)))
+	if err != nil {
+		return err
+	}
+
+	a.buf[o] = byte(rLevel)
+	metaStart := o + 1 + uint32(rLevel+1)*4
+	binary.LittleEndian.PutUint32(a.buf[metaStart:metaStart+4], uint32(len(key)))
+	binary.LittleEndian.PutUint32(a.buf[metaStart+4:metaStart+8], uint32(len(value)))
+	keyStart := metaStart + 8
+	copy(a.buf[keyStart:], key)
+	copy(a.buf[keyStart+uint32(len(key)):], value)
+
+	for i := 0; i <= rLevel; i++ {
+		a.setNodeForward(o, i, a.forwardAt(update[i], i))
+		a.setForwardAt(update[i], i, o)
+	}
+
+	return nil
+}
+
+// Get returns the value stored for key. The returned slice is a view
+// into the arena and must not be mutated by the caller.
+func (a *ArenaSkipList) Get(key []byte) ([]byte, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	curr := nullOffset
+	for i := a.level; i >= 0; i-- {
+		next := a.forwardAt(curr, i)
+		for next != nullOffset && bytes.Compare(a.nodeKey(next), key) == -1 {
+			curr = next
+			next = a.forwardAt(curr, i)
+		}
+	}
+
+	next := a.forwardAt(curr, 0)
+	if next != nullOffset && bytes.Equal(a.nodeKey(next), key) {
+		return a.nodeValue(next), nil
+	}
+
+	return nil, ErrTargetNotFound
+}
+
+// Size returns the number of arena bytes used so far.
+func (a *ArenaSkipList) Size() int {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return int(a.offset)
+}
+
+// Cap returns the arena's total byte capacity.
+func (a *ArenaSkipList) Cap() int {
+	return len(a.buf)
+}
+
+// Full reports whether the arena has no room left for another insert,
+// signaling a memtable built on top of it to rotate to a fresh one.
+// It latches true once any Set has been rejected with ErrArenaFull,
+// even if the arena still has a few slack bytes too small to host the
+// rejected node.
+func (a *ArenaSkipList) Full() bool {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return a.full || int(a.offset) >= len(a.buf)
+}
+
+// ArenaIterator walks an ArenaSkipList's keys in sorted order.
+type ArenaIterator struct {
+	a       *ArenaSkipList
+	current uint32
+}
+
+// Iterate returns an iterator positioned at the smallest key.
+func (a *ArenaSkipList) Iterate() *ArenaIterator {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	return &ArenaIterator{a: a, current: a.head[0]}
+}
+
+func (it *ArenaIterator) Valid() bool {
+	return it.current != nullOffset
+}
+
+func (it *ArenaIterator) Next() {
+	if it.Valid() {
+		it.current = it.a.nodeForward(it.current, 0)
+	}
+}
+
+func (it *ArenaIterator) Key() []byte {
+	return it.a.nodeKey(it.current)
+}
+
+func (it *ArenaIterator) Value() []byte {
+	return it.a.nodeValue(it.current)
+}
@@ -0,0 +1,71 @@
+package skiplist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArenaSetGet(t *testing.T) {
+	a := NewArenaDefault(4096)
+
+	a.Set([]byte("b"), []byte("2"))
+	a.Set([]byte("a"), []byte("1"))
+	a.Set([]byte("c"), []byte("3"))
+
+	v, err := a.Get([]byte("a"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("1"), v)
+
+	v, err = a.Get([]byte("c"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("3"), v)
+
+	_, err = a.Get([]byte("z"))
+	assert.EqualError(t, err, ErrTargetNotFound.Error())
+}
+
+func TestArenaOverwrite(t *testing.T) {
+	a := NewArenaDefault(4096)
+
+	a.Set([]byte("a"), []byte("1"))
+	a.Set([]byte("a"), []byte("updated"))
+
+	v, err := a.Get([]byte("a"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("updated"), v)
+
+	var got []string
+	for it := a.Iterate(); it.Valid(); it.Next() {
+		got = append(got, string(it.Key()))
+	}
+	assert.Equal(t, []string{"a"}, got)
+}
+
+func TestArenaIterateSortedOrder(t *testing.T) {
+	a := NewArenaDefault(4096)
+
+	keys := []string{"d", "b", "a", "c"}
+	for _, k := range keys {
+		a.Set([]byte(k), []byte(k))
+	}
+
+	var got []string
+	for it := a.Iterate(); it.Valid(); it.Next() {
+		got = append(got, string(it.Key()))
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d"}, got)
+}
+
+func TestArenaFull(t *testing.T) {
+	a := NewArena(64, MaxLevel)
+
+	var err error
+	for i := 0; i < 100 && err == nil; i++ {
+		err = a.Set([]byte{byte(i)}, []byte{byte(i)})
+	}
+
+	assert.ErrorIs(t, err, ErrArenaFull)
+	assert.True(t, a.Full())
+	assert.LessOrEqual(t, a.Size(), a.Cap())
+}
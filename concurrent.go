@@ -0,0 +1,170 @@
+package skiplist
+
+import (
+	"container/heap"
+	"hash/fnv"
+	"sync/atomic"
+)
+
+// DefaultShardCount is the number of shards a ConcurrentSkipList uses
+// when none is specified.
+const DefaultShardCount = 32
+
+// HashFn maps an element of type T to a shard-routing hash. It must
+// only depend on the same key fields the list's CompareFn looks at, so
+// that an element always routes to the same shard regardless of how
+// its non-key fields change.
+type HashFn[T any] func(data T) uint64
+
+// HashString hashes a string key for use as a HashFn, using FNV-1a
+// from the standard library rather than xxhash, so this package has
+// no external dependencies. It is not cryptographically secure; it
+// exists only to spread keys evenly across shards.
+func HashString(s string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(s))
+	return h.Sum64()
+}
+
+// ConcurrentSkipList shards a SkipList across N independent lists keyed
+// by hash, so that Set/Search/Delete on different shards don't contend
+// on the same mutex. It trades the single global ordering of SkipList
+// for much higher write throughput under contention.
+type ConcurrentSkipList[T any] struct {
+	shards  []*SkipList[T]
+	lens    []atomic.Int64
+	compare CompareFn[T]
+	hash    HashFn[T]
+}
+
+// Creates a new ConcurrentSkipList with shardCount shards, each an
+// independent SkipList[T] constructed with maxLevel, cmp and opts.
+func NewConcurrent[T any](shardCount, maxLevel int, cmp CompareFn[T], hash HashFn[T], opts ...Option[T]) *ConcurrentSkipList[T] {
+	c := &ConcurrentSkipList[T]{
+		shards:  make([]*SkipList[T], shardCount),
+		lens:    make([]atomic.Int64, shardCount),
+		compare: cmp,
+		hash:    hash,
+	}
+
+	for i := range c.shards {
+		c.shards[i] = New(maxLevel, cmp, opts...)
+	}
+
+	return c
+}
+
+// Creates a new ConcurrentSkipList with DefaultShardCount shards and
+// the default max level of 30.
+func NewConcurrentDefault[T any](cmp CompareFn[T], hash HashFn[T], opts ...Option[T]) *ConcurrentSkipList[T] {
+	return NewConcurrent(DefaultShardCount, MaxLevel, cmp, hash, opts...)
+}
+
+// shardFor returns the index of the shard that data routes to.
+func (c *ConcurrentSkipList[T]) shardFor(data T) int {
+	return int(c.hash(data) % uint64(len(c.shards)))
+}
+
+// Inserts data into its shard if it does not exist already. If an
+// equal element already exists, it is updated with the new one.
+func (c *ConcurrentSkipList[T]) Set(data T) {
+	idx := c.shardFor(data)
+	if c.shards[idx].Set(data) {
+		c.lens[idx].Add(1)
+	}
+}
+
+// Search data from the list.
+func (c *ConcurrentSkipList[T]) Search(data T) (*T, error) {
+	return c.shards[c.shardFor(data)].Search(data)
+}
+
+// Deletes data from the list matching the given element.
+func (c *ConcurrentSkipList[T]) Delete(data T) {
+	idx := c.shardFor(data)
+	if c.shards[idx].Delete(data) {
+		c.lens[idx].Add(-1)
+	}
+}
+
+// Len returns the total number of elements across all shards in O(1),
+// since each shard maintains its own atomic count.
+func (c *ConcurrentSkipList[T]) Len() int {
+	var total int64
+	for i := range c.lens {
+		total += c.lens[i].Load()
+	}
+	return int(total)
+}
+
+// heapItem is one shard's current position during a merge iteration.
+type heapItem[T any] struct {
+	data  T
+	shard int
+	index int
+}
+
+// shardHeap is a min-heap over the current front element of each
+// shard's sorted snapshot, ordered by the list's CompareFn.
+type shardHeap[T any] struct {
+	items   []heapItem[T]
+	compare CompareFn[T]
+}
+
+func (h *shardHeap[T]) Len() int { return len(h.items) }
+func (h *shardHeap[T]) Less(i, j int) bool {
+	return h.compare(h.items[i].data, h.items[j].data) == -1
+}
+func (h *shardHeap[T]) Swap(i, j int) { h.items[i], h.items[j] = h.items[j], h.items[i] }
+func (h *shardHeap[T]) Push(x any)    { h.items = append(h.items, x.(heapItem[T])) }
+func (h *shardHeap[T]) Pop() any {
+	old := h.items
+	n := len(old)
+	item := old[n-1]
+	h.items = old[:n-1]
+	return item
+}
+
+// ConcurrentIterator walks a ConcurrentSkipList in globally sorted
+// order over a per-shard snapshot taken at Iterate time.
+type ConcurrentIterator[T any] struct {
+	snapshots [][]T
+	heap      *shardHeap[T]
+}
+
+// Iterate returns an iterator over a snapshot of every shard, merged
+// into globally sorted order via a min-heap across shards.
+func (c *ConcurrentSkipList[T]) Iterate() *ConcurrentIterator[T] {
+	snapshots := make([][]T, len(c.shards))
+	h := &shardHeap[T]{compare: c.compare}
+
+	for i, shard := range c.shards {
+		snapshots[i] = shard.Sorted()
+		if len(snapshots[i]) > 0 {
+			heap.Push(h, heapItem[T]{data: snapshots[i][0], shard: i, index: 0})
+		}
+	}
+
+	return &ConcurrentIterator[T]{snapshots: snapshots, heap: h}
+}
+
+func (it *ConcurrentIterator[T]) Valid() bool {
+	return it.heap.Len() > 0
+}
+
+func (it *ConcurrentIterator[T]) Data() T {
+	return it.heap.items[0].data
+}
+
+func (it *ConcurrentIterator[T]) Next() {
+	if !it.Valid() {
+		return
+	}
+
+	top := heap.Pop(it.heap).(heapItem[T])
+
+	next := top.index + 1
+	if next < len(it.snapshots[top.shard]) {
+		heap.Push(it.heap, heapItem[T]{data: it.snapshots[top.shard][next], shard: top.shard, index: next})
+	}
+}
@@ -0,0 +1,69 @@
+package skiplist
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func hashData(d Data) uint64 {
+	return HashString(d.Key)
+}
+
+func TestConcurrentSetSearchDelete(t *testing.T) {
+	list := NewConcurrentDefault(cmpData, hashData)
+
+	list.Set(Data{Key: "a", Value: []byte{1}})
+	list.Set(Data{Key: "b", Value: []byte{2}})
+
+	res, err := list.Search(Data{Key: "a"})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{1}, res.Value)
+
+	list.Set(Data{Key: "a", Value: []byte{9}})
+	res, err = list.Search(Data{Key: "a"})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{9}, res.Value)
+
+	assert.Equal(t, 2, list.Len())
+
+	list.Delete(Data{Key: "a"})
+	_, err = list.Search(Data{Key: "a"})
+	assert.EqualError(t, err, ErrTargetNotFound.Error())
+
+	assert.Equal(t, 1, list.Len())
+}
+
+func TestConcurrentLenUnderContention(t *testing.T) {
+	list := NewConcurrentDefault(cmpData, hashData)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			list.Set(Data{Key: strconv.Itoa(i), Value: []byte{byte(i)}})
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 100, list.Len())
+}
+
+func TestConcurrentIterate(t *testing.T) {
+	list := NewConcurrentDefault(cmpData, hashData)
+
+	keys := []string{"d", "b", "a", "c"}
+	for _, k := range keys {
+		list.Set(Data{Key: k, Value: []byte(k)})
+	}
+
+	var got []string
+	for it := list.Iterate(); it.Valid(); it.Next() {
+		got = append(got, it.Data().Key)
+	}
+
+	assert.Equal(t, []string{"a", "b", "c", "d"}, got)
+}
@@ -0,0 +1,107 @@
+package skiplist
+
+// SeekFirst returns an iterator positioned at the smallest element in
+// the list. The iterator is invalid if the list is empty.
+func (s *SkipList[T]) SeekFirst() *Iterator[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return &Iterator[T]{current: s.Header.Forward[0], compare: s.compare}
+}
+
+// SeekLast returns an iterator positioned at the largest element in
+// the list. The iterator is invalid if the list is empty.
+func (s *SkipList[T]) SeekLast() *Iterator[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	curr := s.Header
+	for i := s.Level; i >= 0; i-- {
+		for curr.Forward[i] != nil {
+			curr = curr.Forward[i]
+		}
+	}
+
+	if curr == s.Header {
+		return &Iterator[T]{compare: s.compare}
+	}
+
+	return &Iterator[T]{current: curr, compare: s.compare}
+}
+
+// SeekGE returns an iterator positioned at the first element greater
+// than or equal to target.
+func (s *SkipList[T]) SeekGE(target T) *Iterator[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	curr := s.Header
+	for i := s.Level; i >= 0; i-- {
+		for curr.Forward[i] != nil && s.compare(curr.Forward[i].Data, target) == -1 {
+			curr = curr.Forward[i]
+		}
+	}
+
+	return &Iterator[T]{current: curr.Forward[0], compare: s.compare}
+}
+
+// SeekLT returns an iterator positioned at the last element strictly
+// less than target.
+func (s *SkipList[T]) SeekLT(target T) *Iterator[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	curr := s.Header
+	for i := s.Level; i >= 0; i-- {
+		for curr.Forward[i] != nil && s.compare(curr.Forward[i].Data, target) == -1 {
+			curr = curr.Forward[i]
+		}
+	}
+
+	if curr == s.Header {
+		return &Iterator[T]{compare: s.compare}
+	}
+
+	return &Iterator[T]{current: curr, compare: s.compare}
+}
+
+// Range returns an iterator over the elements in [lo, hi], with lo and
+// hi included or excluded according to loInclusive and hiInclusive.
+// Iterating with Next stays within the bound until it is exhausted.
+func (s *SkipList[T]) Range(lo, hi T, loInclusive, hiInclusive bool) *Iterator[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	curr := s.Header
+	for i := s.Level; i >= 0; i-- {
+		for curr.Forward[i] != nil {
+			c := s.compare(curr.Forward[i].Data, lo)
+			if c == -1 || (c == 0 && !loInclusive) {
+				curr = curr.Forward[i]
+			} else {
+				break
+			}
+		}
+	}
+
+	return &Iterator[T]{
+		current:     curr.Forward[0],
+		compare:     s.compare,
+		hasLo:       true,
+		lo:          lo,
+		loInclusive: loInclusive,
+		hasHi:       true,
+		hi:          hi,
+		hiInclusive: hiInclusive,
+	}
+}
+
+// RangeCount returns the number of elements in [lo, hi], with lo and hi
+// included or excluded according to loInclusive and hiInclusive.
+func (s *SkipList[T]) RangeCount(lo, hi T, loInclusive, hiInclusive bool) int {
+	count := 0
+	for it := s.Range(lo, hi, loInclusive, hiInclusive); it.Valid(); it.Next() {
+		count++
+	}
+	return count
+}
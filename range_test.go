@@ -0,0 +1,80 @@
+package skiplist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func seedRangeList() *SkipList[Data] {
+	list := NewDefault(cmpData)
+	for _, k := range []string{"a", "b", "c", "d", "e"} {
+		list.Set(Data{Key: k, Value: []byte(k)})
+	}
+	return list
+}
+
+func TestSeekFirstLast(t *testing.T) {
+	list := seedRangeList()
+
+	it := list.SeekFirst()
+	assert.Equal(t, "a", it.Data().Key)
+
+	it = list.SeekLast()
+	assert.Equal(t, "e", it.Data().Key)
+
+	empty := NewDefault(cmpData)
+	assert.False(t, empty.SeekFirst().Valid())
+	assert.False(t, empty.SeekLast().Valid())
+}
+
+func TestSeekGELT(t *testing.T) {
+	list := seedRangeList()
+
+	it := list.SeekGE(Data{Key: "c"})
+	assert.Equal(t, "c", it.Data().Key)
+
+	it = list.SeekGE(Data{Key: "c1"})
+	assert.Equal(t, "d", it.Data().Key)
+
+	it = list.SeekLT(Data{Key: "c"})
+	assert.Equal(t, "b", it.Data().Key)
+
+	it = list.SeekLT(Data{Key: "a"})
+	assert.False(t, it.Valid())
+
+	it = list.SeekGE(Data{Key: "z"})
+	assert.False(t, it.Valid())
+}
+
+func TestIteratorPrev(t *testing.T) {
+	list := seedRangeList()
+
+	it := list.SeekLast()
+	var got []string
+	for it.Valid() {
+		got = append(got, it.Data().Key)
+		it.Prev()
+	}
+
+	assert.Equal(t, []string{"e", "d", "c", "b", "a"}, got)
+}
+
+func TestRange(t *testing.T) {
+	list := seedRangeList()
+
+	var got []string
+	for it := list.Range(Data{Key: "b"}, Data{Key: "d"}, true, false); it.Valid(); it.Next() {
+		got = append(got, it.Data().Key)
+	}
+	assert.Equal(t, []string{"b", "c"}, got)
+
+	got = nil
+	for it := list.Range(Data{Key: "b"}, Data{Key: "d"}, true, true); it.Valid(); it.Next() {
+		got = append(got, it.Data().Key)
+	}
+	assert.Equal(t, []string{"b", "c", "d"}, got)
+
+	assert.Equal(t, 2, list.RangeCount(Data{Key: "b"}, Data{Key: "d"}, true, false))
+	assert.Equal(t, 3, list.RangeCount(Data{Key: "b"}, Data{Key: "d"}, true, true))
+}
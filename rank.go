@@ -0,0 +1,53 @@
+package skiplist
+
+// Rank returns the 0-based position of target in sorted order. It runs
+// in O(log n) by summing the span of each forward pointer taken on the
+// way down, the same way Set and Delete keep those spans up to date.
+func (s *SkipList[T]) Rank(target T) (int, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	curr := s.Header
+	rank := 0
+
+	for i := s.Level; i >= 0; i-- {
+		for curr.Forward[i] != nil && s.compare(curr.Forward[i].Data, target) != 1 {
+			rank += curr.Span[i]
+			curr = curr.Forward[i]
+		}
+	}
+
+	if curr != s.Header && s.compare(curr.Data, target) == 0 {
+		return rank - 1, nil
+	}
+
+	return 0, ErrTargetNotFound
+}
+
+// SelectByIndex returns the element at the given 0-based index in
+// sorted order, in O(log n).
+func (s *SkipList[T]) SelectByIndex(i int) (*T, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if i < 0 {
+		return nil, ErrIndexOutOfRange
+	}
+
+	target := i + 1
+	curr := s.Header
+	traversed := 0
+
+	for lvl := s.Level; lvl >= 0; lvl-- {
+		for curr.Forward[lvl] != nil && traversed+curr.Span[lvl] <= target {
+			traversed += curr.Span[lvl]
+			curr = curr.Forward[lvl]
+		}
+
+		if traversed == target {
+			return &curr.Data, nil
+		}
+	}
+
+	return nil, ErrIndexOutOfRange
+}
@@ -0,0 +1,58 @@
+package skiplist
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRank(t *testing.T) {
+	list := NewDefault(cmpData)
+	for _, k := range []string{"a", "b", "c", "d", "e"} {
+		list.Set(Data{Key: k, Value: []byte(k)})
+	}
+
+	for i, k := range []string{"a", "b", "c", "d", "e"} {
+		rank, err := list.Rank(Data{Key: k})
+		assert.NoError(t, err)
+		assert.Equal(t, i, rank)
+	}
+
+	_, err := list.Rank(Data{Key: "z"})
+	assert.EqualError(t, err, ErrTargetNotFound.Error())
+}
+
+func TestRankAfterDelete(t *testing.T) {
+	list := NewDefault(cmpData)
+	for _, k := range []string{"a", "b", "c", "d", "e"} {
+		list.Set(Data{Key: k, Value: []byte(k)})
+	}
+
+	list.Delete(Data{Key: "b"})
+
+	for i, k := range []string{"a", "c", "d", "e"} {
+		rank, err := list.Rank(Data{Key: k})
+		assert.NoError(t, err)
+		assert.Equal(t, i, rank)
+	}
+}
+
+func TestSelectByIndex(t *testing.T) {
+	list := NewDefault(cmpData)
+	keys := []string{"a", "b", "c", "d", "e"}
+	for _, k := range keys {
+		list.Set(Data{Key: k, Value: []byte(k)})
+	}
+
+	for i, k := range keys {
+		res, err := list.SelectByIndex(i)
+		assert.NoError(t, err)
+		assert.Equal(t, k, res.Key)
+	}
+
+	_, err := list.SelectByIndex(len(keys))
+	assert.EqualError(t, err, ErrIndexOutOfRange.Error())
+
+	_, err = list.SelectByIndex(-1)
+	assert.EqualError(t, err, ErrIndexOutOfRange.Error())
+}
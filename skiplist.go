@@ -1,49 +1,60 @@
 package skiplist
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"math/rand"
-	"strings"
 	"sync"
+	"time"
 )
 
 // Global maximum level of skip list.
 const MaxLevel int = 30
 
-var ErrKeyNotFound = errors.New("key not found")
+// DefaultP is the level-promotion probability used by randomLevel when
+// no WithP option is given.
+const DefaultP float64 = 0.5
 
-// Used to iterate the skiplist elements
-// in a sorted order.
-type Iterator struct {
-	current *Node
-}
+var ErrTargetNotFound = errors.New("target not found")
 
-type NewData[T any] interface {
+var ErrIndexOutOfRange = errors.New("index out of range")
 
-	// Compares two data keys in a sorting context. Returns
-	// 1 if data a is larger than b,
-	// 0 if data a is the same as b,
-	// -1 if data a is smaller than b.
-	Compare(a, b *T) bool
-}
+// CompareFn orders two elements of type T in a sorting context. It
+// returns 1 if a is larger than b, 0 if they are equal, and -1 if a is
+// smaller than b.
+type CompareFn[T any] func(a, b T) int
+
+// Option configures a SkipList at construction time.
+type Option[T any] func(*SkipList[T])
 
-// Defines the data that will be stored in the list.
-type Data struct {
-	Key   string
-	Value []byte
+// WithP sets the level-promotion probability p used by randomLevel.
+// Lower values (e.g. 0.25, as used by Redis) promote fewer nodes to each
+// higher level, so nodes carry fewer forward pointers on average.
+func WithP[T any](p float64) Option[T] {
+	return func(s *SkipList[T]) {
+		s.p = p
+	}
 }
 
-// Compares two data keys in a sorting context. Returns
-// 1 if data a is larger than b,
-// 0 if data a is the same as b,
-// -1 if data a is smaller than b.
-func compare(a, b *Data) int {
-	return strings.Compare(a.Key, b.Key)
+// Used to iterate the skiplist elements
+// in a sorted order. The zero value is not valid; obtain one from
+// SkipList's Iterate, Seek* or Range methods.
+type Iterator[T any] struct {
+	current *Node[T]
+	compare CompareFn[T]
+
+	hasLo       bool
+	lo          T
+	loInclusive bool
+
+	hasHi       bool
+	hi          T
+	hiInclusive bool
 }
 
 // Defines the skip list
-type SkipList struct {
+type SkipList[T any] struct {
 	mu sync.RWMutex
 
 	// Max possible level for this skip list.
@@ -53,68 +64,129 @@ type SkipList struct {
 	Level int
 
 	// Pointer to header node.
-	Header *Node
+	Header *Node[T]
+
+	// compare orders two elements of type T.
+	compare CompareFn[T]
+
+	// p is the level-promotion probability used by randomLevel.
+	p float64
+
+	// rnd is this list's own random source, so concurrent lists don't
+	// contend on the global math/rand mutex.
+	rnd *rand.Rand
 }
 
 // Defines a Node in the list
-type Node struct {
+type Node[T any] struct {
 	// Data is the data stored in a node.
-	Data Data
+	Data T
 
 	// Forward is a slice containing nodes in a level
 	// that are linked from this node.
-	Forward []*Node
+	Forward []*Node[T]
+
+	// Backward points to this node's predecessor at level 0, or nil if
+	// this is the first node. It makes the base list doubly-linked so
+	// it can be walked in reverse without recomputing the search path.
+	Backward *Node[T]
+
+	// Span holds, for each entry in Forward, how many level-0 nodes
+	// that forward pointer skips over. Span[i] is only kept accurate
+	// while Forward[i] is non-nil; for a nil Forward[i] its value has no
+	// meaning. Summing spans along a search path gives an element's rank
+	// in O(log n) instead of walking the base list.
+	Span []int
 }
 
-// Creates a new skip list.
-func New(maxLevel int) SkipList {
+// Creates a new skip list that orders elements using cmp. Options such
+// as WithP can be passed to configure the level-promotion probability.
+func New[T any](maxLevel int, cmp CompareFn[T], opts ...Option[T]) *SkipList[T] {
+	var zero T
 
 	// Create new node with dummy data as header.
-	node := Node{
-		Data: Data{
-			Key:   "",
-			Value: []byte(""),
-		},
-		Forward: make([]*Node, maxLevel+1),
+	node := Node[T]{
+		Data:    zero,
+		Forward: make([]*Node[T], maxLevel+1),
+		Span:    make([]int, maxLevel+1),
 	}
 
-	return SkipList{
+	s := &SkipList[T]{
 		MaxLevel: maxLevel,
 		Level:    0,
 		Header:   &node,
+		compare:  cmp,
+		p:        DefaultP,
+		rnd:      rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+
+	for _, opt := range opts {
+		opt(s)
 	}
+
+	return s
 }
 
-// Creates a new skip list with the default max level of 30
-func NewDefault() SkipList {
-	return New(MaxLevel)
+// Creates a new skip list with the default max level of 30.
+func NewDefault[T any](cmp CompareFn[T], opts ...Option[T]) *SkipList[T] {
+	return New(MaxLevel, cmp, opts...)
 }
 
-// Generates a random integer ranging from 0 to the max level of the skip list.
-func (s *SkipList) randomLevel() int {
-	return rand.Intn(s.MaxLevel)
+// KV pairs a key and a value, for use with NewOrdered when an element
+// needs to carry a value alongside its key.
+type KV[K cmp.Ordered, V any] struct {
+	Key   K
+	Value V
 }
 
-// Inserts data to the list if key does not exist already.
-// If the key already exists, the value will be updated with the new one.
-func (s *SkipList) Set(key string, value []byte) {
-	s.mu.Lock()
-	defer s.mu.Unlock()
+// Creates a new skip list keyed by an ordered type, comparing elements
+// by their Key field. This preserves the ergonomics of the original
+// string-keyed API (e.g. NewOrdered[string, []byte](MaxLevel)) without
+// requiring callers to write their own comparator.
+func NewOrdered[K cmp.Ordered, V any](maxLevel int, opts ...Option[KV[K, V]]) *SkipList[KV[K, V]] {
+	return New(maxLevel, func(a, b KV[K, V]) int {
+		return cmp.Compare(a.Key, b.Key)
+	}, opts...)
+}
 
-	data := Data{
-		Key:   key,
-		Value: value,
+// Generates a level for a newly inserted node using the canonical
+// geometric distribution from Pugh's skip list paper: each level above
+// 0 is promoted into with probability p, independently of the others.
+// This keeps the expected search cost O(log n) instead of the O(n)
+// worst case a uniform level choice allows.
+func (s *SkipList[T]) randomLevel() int {
+	level := 0
+	for s.rnd.Float64() < s.p && level < s.MaxLevel-1 {
+		level++
 	}
+	return level
+}
+
+// Inserts data to the list if it does not exist already.
+// If an equal element already exists, it will be updated with the new one.
+// Set reports whether data was newly inserted, as opposed to replacing
+// an existing element.
+func (s *SkipList[T]) Set(data T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
 	curr := s.Header
-	update := make([]*Node, s.MaxLevel+1)
+	update := make([]*Node[T], s.MaxLevel+1)
+	rank := make([]int, s.MaxLevel+1)
 
 	for i := s.Level; i >= 0; i-- {
-		for curr.Forward[i] != nil && compare(&curr.Forward[i].Data, &data) == -1 {
+		if i == s.Level {
+			rank[i] = 0
+		} else {
+			rank[i] = rank[i+1]
+		}
+
+		for curr.Forward[i] != nil && s.compare(curr.Forward[i].Data, data) == -1 {
+			rank[i] += curr.Span[i]
 			curr = curr.Forward[i]
 		}
 
-		if curr.Forward[i] != nil && compare(&curr.Forward[i].Data, &data) == 0 {
+		if curr.Forward[i] != nil && s.compare(curr.Forward[i].Data, data) == 0 {
 			curr.Forward[i].Data = data
 		}
 
@@ -123,71 +195,84 @@ func (s *SkipList) Set(key string, value []byte) {
 
 	curr = curr.Forward[0]
 
-	if curr == nil || compare(&curr.Data, &data) != 0 {
+	if curr == nil || s.compare(curr.Data, data) != 0 {
 		rLevel := s.randomLevel()
 
 		if rLevel > s.Level {
 			for i := s.Level + 1; i < rLevel+1; i++ {
 				update[i] = s.Header
+				rank[i] = 0
 			}
 
 			s.Level = rLevel
 		}
 
-		n := Node{
+		n := Node[T]{
 			Data:    data,
-			Forward: make([]*Node, rLevel+1),
+			Forward: make([]*Node[T], rLevel+1),
+			Span:    make([]int, rLevel+1),
 		}
 
 		for i := 0; i <= rLevel; i++ {
 			n.Forward[i] = update[i].Forward[i]
 			update[i].Forward[i] = &n
+
+			n.Span[i] = update[i].Span[i] - (rank[0] - rank[i])
+			update[i].Span[i] = rank[0] - rank[i] + 1
+		}
+
+		for i := rLevel + 1; i <= s.Level; i++ {
+			update[i].Span[i]++
+		}
+
+		if update[0] != s.Header {
+			n.Backward = update[0]
+		}
+		if n.Forward[0] != nil {
+			n.Forward[0].Backward = &n
 		}
+
+		return true
 	}
+
+	return false
 }
 
 // Search data from the list.
-func (s *SkipList) Search(key string) (*Data, error) {
+func (s *SkipList[T]) Search(data T) (*T, error) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	data := Data{
-		Key: key,
-	}
-
 	curr := s.Header
 
 	for i := s.Level; i >= 0; i-- {
-		for curr.Forward[i] != nil && compare(&curr.Forward[i].Data, &data) == -1 {
+		for curr.Forward[i] != nil && s.compare(curr.Forward[i].Data, data) == -1 {
 			curr = curr.Forward[i]
 		}
 	}
 
 	curr = curr.Forward[0]
 
-	if curr != nil && compare(&curr.Data, &data) == 0 {
+	if curr != nil && s.compare(curr.Data, data) == 0 {
 		return &curr.Data, nil
 	}
 
-	return nil, ErrKeyNotFound
+	return nil, ErrTargetNotFound
 }
 
-// Deletes a data from the list with specified data.
-// The data is compared using the compare() function.
-func (s *SkipList) Delete(key string) {
+// Deletes data from the list matching the given element.
+// The data is compared using the skip list's CompareFn. Delete reports
+// whether a matching element was found and removed.
+func (s *SkipList[T]) Delete(data T) bool {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	data := Data{
-		Key: key,
-	}
-
 	curr := s.Header
 
-	update := make([]*Node, s.MaxLevel+1)
+	update := make([]*Node[T], s.MaxLevel+1)
 
 	for i := s.Level; i >= 0; i-- {
-		for curr.Forward[i] != nil && compare(&curr.Forward[i].Data, &data) == -1 {
+		for curr.Forward[i] != nil && s.compare(curr.Forward[i].Data, data) == -1 {
 			curr = curr.Forward[i]
 		}
 		update[i] = curr
@@ -195,23 +280,32 @@ func (s *SkipList) Delete(key string) {
 
 	curr = curr.Forward[0]
 
-	if curr != nil && compare(&curr.Data, &data) == 0 {
+	if curr != nil && s.compare(curr.Data, data) == 0 {
 		for i := 0; i <= s.Level; i++ {
-			if update[i].Forward[i] != curr {
-				break
+			if update[i].Forward[i] == curr {
+				update[i].Span[i] += curr.Span[i] - 1
+				update[i].Forward[i] = curr.Forward[i]
+			} else {
+				update[i].Span[i]--
 			}
+		}
 
-			update[i].Forward[i] = curr.Forward[i]
+		if curr.Forward[0] != nil {
+			curr.Forward[0].Backward = curr.Backward
 		}
 
 		for s.Level > 0 && s.Header.Forward[s.Level] == nil {
 			s.Level--
 		}
+
+		return true
 	}
+
+	return false
 }
 
 // Prints all the elements at the bottom level of the list.
-func (s *SkipList) Print() {
+func (s *SkipList[T]) Print() {
 	for _, v := range s.Sorted() {
 		fmt.Print(v, " ")
 	}
@@ -219,11 +313,11 @@ func (s *SkipList) Print() {
 }
 
 // Returns a slice containing all the elements of the skiplist in sorted order.
-func (s *SkipList) Sorted() []Data {
+func (s *SkipList[T]) Sorted() []T {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	var res []Data
+	var res []T
 
 	node := s.Header.Forward[0]
 	for node != nil {
@@ -234,7 +328,7 @@ func (s *SkipList) Sorted() []Data {
 	return res
 }
 
-func (s *SkipList) Len() int {
+func (s *SkipList[T]) Len() int {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
@@ -248,22 +342,58 @@ func (s *SkipList) Len() int {
 	return len
 }
 
-func (s *SkipList) Iterate() *Iterator {
-	return &Iterator{
+func (s *SkipList[T]) Iterate() *Iterator[T] {
+	return &Iterator[T]{
 		current: s.Header.Forward[0],
+		compare: s.compare,
 	}
 }
 
-func (i *Iterator) Valid() bool {
-	return i.current != nil
+// Valid reports whether the iterator is positioned at an element,
+// honoring any bounds set by Range.
+func (i *Iterator[T]) Valid() bool {
+	if i.current == nil {
+		return false
+	}
+
+	if i.hasHi {
+		c := i.compare(i.current.Data, i.hi)
+		if i.hiInclusive {
+			if c == 1 {
+				return false
+			}
+		} else if c != -1 {
+			return false
+		}
+	}
+
+	if i.hasLo {
+		c := i.compare(i.current.Data, i.lo)
+		if i.loInclusive {
+			if c == -1 {
+				return false
+			}
+		} else if c != 1 {
+			return false
+		}
+	}
+
+	return true
 }
 
-func (i *Iterator) Next() {
+func (i *Iterator[T]) Next() {
 	if i.Valid() {
 		i.current = i.current.Forward[0]
 	}
 }
 
-func (i *Iterator) Data() Data {
+// Prev moves the iterator to the previous (next smallest) element.
+func (i *Iterator[T]) Prev() {
+	if i.Valid() {
+		i.current = i.current.Backward
+	}
+}
+
+func (i *Iterator[T]) Data() T {
 	return i.current.Data
 }
@@ -2,6 +2,7 @@ package skiplist
 
 import (
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -131,3 +132,64 @@ func TestIterator(t *testing.T) {
 
 	assert.Equal(t, it.Valid(), false)
 }
+
+func TestRandomLevelDistribution(t *testing.T) {
+	type test struct {
+		description string
+		p           float64
+	}
+
+	tests := []test{
+		{description: "default p", p: DefaultP},
+		{description: "redis-style p", p: 0.25},
+	}
+
+	const samples = 50000
+	// minBinSamples is the smallest bin we'll assert on. Below this,
+	// binomial noise is too large for any fixed tolerance to be both
+	// meaningful and stable.
+	const minBinSamples = 1000
+	// sigmas sets how many standard deviations of slack a bin gets
+	// around its expected count, rather than a fixed percentage
+	// tolerance: since stddev shrinks with bin size anyway, this keeps
+	// the chance of a spurious failure negligible at any sample size.
+	const sigmas = 6
+
+	for _, tc := range tests {
+		t.Run(tc.description, func(t *testing.T) {
+			list := NewDefault(cmpData, WithP[Data](tc.p))
+
+			counts := make(map[int]int)
+			maxLevel := 0
+			for i := 0; i < samples; i++ {
+				level := list.randomLevel()
+				counts[level]++
+				if level > maxLevel {
+					maxLevel = level
+				}
+			}
+
+			// survival[k] is the number of samples that reached level
+			// k or higher. Unlike the raw per-level counts, each
+			// sample that survives to level k independently survives
+			// to level k+1 with probability p, so survival[k+1] is
+			// exactly Binomial(survival[k], p) — this is the relation
+			// worth asserting on, not a ratio of per-level bin counts.
+			survival := make([]int, maxLevel+2)
+			for level := maxLevel; level >= 0; level-- {
+				survival[level] = counts[level] + survival[level+1]
+			}
+
+			for level := 0; survival[level] >= minBinSamples; level++ {
+				n := float64(survival[level])
+				got := float64(survival[level+1])
+				want := n * tc.p
+				margin := sigmas * math.Sqrt(n*tc.p*(1-tc.p))
+
+				assert.InDeltaf(t, want, got, margin,
+					"survival[%d]->survival[%d]: got %.0f, want %.0f +/- %.0f (p=%.2f)",
+					level, level+1, got, want, margin, tc.p)
+			}
+		})
+	}
+}